@@ -1,6 +1,10 @@
 package lockable
 
 import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"runtime"
 	"sync"
 )
 
@@ -91,26 +95,278 @@ type (
 	//			}
 	//		}
 	Lockable[T comparable] struct {
-		locks   map[T]*versionedMutex
-		locksMu *sync.Mutex
+		shards []*lockShard[T]
+		hasher Hasher[T]
+		pool   *sync.Pool
 	}
+	// lockShard holds a fraction of a Lockable's keys behind its own mutex, so that locking unrelated
+	// keys doesn't contend on the same map lock.
+	lockShard[T comparable] struct {
+		mu    sync.Mutex
+		locks map[T]*versionedMutex
+	}
+	// Hasher computes the shard-routing hash for a key. It's only used to spread keys across shards,
+	// not for any security-sensitive purpose, so collisions are fine as long as they're infrequent.
+	Hasher[T comparable] func(key T) uint64
+	// versionedMutex is a cancellable read/write lock.
+	//
+	// It deliberately avoids sync.RWMutex so that a pending Lock/RLock can be abandoned by selecting
+	// on waitCh alongside a context's Done channel, instead of blocking until the lock is granted.
 	versionedMutex struct {
-		sync.RWMutex
 		completedVersion int
 		currentVersion   int
+
+		mu        sync.Mutex
+		writing   bool
+		readers   int
+		upgrading bool
+		waitCh    chan struct{}
 	}
 )
 
+func newVersionedMutex() *versionedMutex {
+	return &versionedMutex{waitCh: make(chan struct{})}
+}
+
+// Lock acquires the write lock, blocking until it's available.
+func (v *versionedMutex) Lock() {
+	v.mu.Lock()
+	for v.writing || v.readers > 0 {
+		ch := v.waitCh
+		v.mu.Unlock()
+		<-ch
+		v.mu.Lock()
+	}
+	v.writing = true
+	v.mu.Unlock()
+}
+
+// TryLock acquires the write lock without blocking, reporting whether it succeeded.
+func (v *versionedMutex) TryLock() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.writing || v.readers > 0 {
+		return false
+	}
+	v.writing = true
+	return true
+}
+
+// LockContext acquires the write lock, returning ctx.Err() if ctx is done while waiting for it. A lock
+// that is free right away is acquired without checking ctx.
+func (v *versionedMutex) LockContext(ctx context.Context) error {
+	v.mu.Lock()
+	for v.writing || v.readers > 0 {
+		ch := v.waitCh
+		v.mu.Unlock()
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		v.mu.Lock()
+	}
+	v.writing = true
+	v.mu.Unlock()
+	return nil
+}
+
+// Unlock releases the write lock.
+func (v *versionedMutex) Unlock() {
+	v.mu.Lock()
+	v.writing = false
+	v.notify()
+	v.mu.Unlock()
+}
+
+// RLock acquires a read lock, blocking until it's available.
+func (v *versionedMutex) RLock() {
+	v.mu.Lock()
+	for v.writing || v.upgrading {
+		ch := v.waitCh
+		v.mu.Unlock()
+		<-ch
+		v.mu.Lock()
+	}
+	v.readers++
+	v.mu.Unlock()
+}
+
+// TryRLock acquires a read lock without blocking, reporting whether it succeeded.
+func (v *versionedMutex) TryRLock() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.writing || v.upgrading {
+		return false
+	}
+	v.readers++
+	return true
+}
+
+// RLockContext acquires a read lock, returning ctx.Err() if ctx is done while waiting for it. A lock
+// that is free right away is acquired without checking ctx.
+func (v *versionedMutex) RLockContext(ctx context.Context) error {
+	v.mu.Lock()
+	for v.writing || v.upgrading {
+		ch := v.waitCh
+		v.mu.Unlock()
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		v.mu.Lock()
+	}
+	v.readers++
+	v.mu.Unlock()
+	return nil
+}
+
+// RUnlock releases a read lock.
+func (v *versionedMutex) RUnlock() {
+	v.mu.Lock()
+	v.readers--
+	// Always notify, even if other readers remain: Upgrade waits for readers to drop to 1 (not 0), and
+	// every waiter loops and rechecks its condition, so a spurious wakeup here is harmless.
+	v.notify()
+	v.mu.Unlock()
+}
+
+// Upgrade atomically transitions one of the currently held read locks into the write lock. The caller
+// must already hold a read lock (it's a run-time error if it doesn't, mirroring sync.RWMutex's handling
+// of unpaired Unlock calls); it sets upgrading to shut out new readers, then waits only for the other
+// readers to drain (not itself), so it can't be overtaken by a writer that was already queued behind the
+// existing readers.
+//
+// At most one reader may be upgrading a given key at a time: if two readers both call Upgrade, neither
+// ever releases its own read lock, so the second would wait on the first's readers forever. Rather than
+// deadlock silently, a second concurrent Upgrade panics.
+func (v *versionedMutex) Upgrade() {
+	v.mu.Lock()
+	if v.readers < 1 {
+		v.mu.Unlock()
+		panic("lockable: Upgrade called without holding a read lock")
+	}
+	if v.upgrading {
+		v.mu.Unlock()
+		panic("lockable: concurrent Upgrade on the same key")
+	}
+
+	v.upgrading = true
+	for v.readers > 1 {
+		ch := v.waitCh
+		v.mu.Unlock()
+		<-ch
+		v.mu.Lock()
+	}
+	v.readers = 0
+	v.writing = true
+	v.upgrading = false
+	v.mu.Unlock()
+}
+
+// Downgrade atomically transitions the held write lock into a read lock. The caller must already hold
+// the write lock (it's a run-time error if it doesn't, mirroring sync.RWMutex's handling of unpaired
+// Unlock calls).
+func (v *versionedMutex) Downgrade() {
+	v.mu.Lock()
+	if !v.writing {
+		v.mu.Unlock()
+		panic("lockable: Downgrade called without holding the write lock")
+	}
+
+	v.writing = false
+	v.readers = 1
+	v.notify()
+	v.mu.Unlock()
+}
+
+// notify wakes up every waiter parked on waitCh. Must be called with mu held.
+func (v *versionedMutex) notify() {
+	close(v.waitCh)
+	v.waitCh = make(chan struct{})
+}
+
+// defaultShardCount is used by [New] as a reasonable default that scales with the number of usable CPUs,
+// making single-shard contention unlikely under concurrent access.
+func defaultShardCount() int {
+	return 128 * runtime.GOMAXPROCS(0)
+}
+
 // New creates a ready-to-use Lockable instance.
 //
 // Refer to [Lockable] for usage
 func New[T comparable]() Lockable[T] {
+	return NewShardedLockable[T](defaultShardCount(), nil)
+}
+
+// NewShardedLockable creates a ready-to-use Lockable instance with its internal map split across the
+// given number of shards, each guarded by its own mutex. More shards trade a bit of memory for less
+// contention under concurrent access to unrelated keys, since callers locking different keys are likely
+// to land on different shards. [New] uses a default shard count of 128 * GOMAXPROCS.
+//
+// hasher routes a key to its shard. A nil hasher falls back to a default that uses built-in integer keys
+// as-is, hashes strings with fnv-1a, and hashes any other key with fnv-1a over fmt.Sprint(key); that last
+// case works for any comparable T but is slower than a type-specific hasher.
+func NewShardedLockable[T comparable](shards int, hasher Hasher[T]) Lockable[T] {
+	if shards < 1 {
+		shards = 1
+	}
+	if hasher == nil {
+		hasher = defaultHasher[T]
+	}
+
+	shardSlice := make([]*lockShard[T], shards)
+	for i := range shardSlice {
+		shardSlice[i] = &lockShard[T]{locks: map[T]*versionedMutex{}}
+	}
+
 	return Lockable[T]{
-		locks:   map[T]*versionedMutex{},
-		locksMu: &sync.Mutex{},
+		shards: shardSlice,
+		hasher: hasher,
+		pool:   &sync.Pool{New: func() any { return newVersionedMutex() }},
 	}
 }
 
+// defaultHasher hashes key using fnv-1a over its fmt.Sprint representation, with fast paths for strings
+// and the built-in integer kinds to avoid the cost of fmt.Sprint on these common cases.
+func defaultHasher[T comparable](key T) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return hashString(k)
+	case int:
+		return uint64(k)
+	case int8:
+		return uint64(k)
+	case int16:
+		return uint64(k)
+	case int32:
+		return uint64(k)
+	case int64:
+		return uint64(k)
+	case uint:
+		return uint64(k)
+	case uint8:
+		return uint64(k)
+	case uint16:
+		return uint64(k)
+	case uint32:
+		return uint64(k)
+	case uint64:
+		return k
+	default:
+		return hashString(fmt.Sprint(key))
+	}
+}
+
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
+
 // LockKey method is used to acquire read/write locks.
 //
 // Use [Lockable.RLockKey] for read locks.
@@ -123,11 +379,14 @@ func (l Lockable[T]) LockKey(key T) {
 //
 // Can safely be called multiple times on the same key.
 func (l Lockable[T]) UnlockKey(key T) {
-	vMu, ok := l.unlockKey(key)
+	vMu, ok, cleaned := l.unlockKey(key)
 	if !ok {
 		return
 	}
 	vMu.Unlock()
+	if cleaned {
+		l.releaseToPool(vMu)
+	}
 }
 
 // LockKeyDuring will automatically acquire a read/write lock before executing fn and release it once done.
@@ -138,6 +397,51 @@ func (l Lockable[T]) LockKeyDuring(key T, fn func() (any, error)) (any, error) {
 	return fn()
 }
 
+// LockKeyContext is used to acquire a read/write lock, aborting if ctx is done while waiting for the lock.
+// If the lock is free right away, it's acquired even when ctx is already done.
+//
+// On cancellation, UnlockKey must not be called since the lock was never acquired.
+func (l Lockable[T]) LockKeyContext(ctx context.Context, key T) error {
+	vMu := l.lockKey(key)
+
+	if err := vMu.LockContext(ctx); err != nil {
+		if _, _, cleaned := l.unlockKey(key); cleaned {
+			l.releaseToPool(vMu)
+		}
+		return err
+	}
+	return nil
+}
+
+// TryLockKey attempts to acquire a read/write lock without blocking, reporting whether it succeeded.
+//
+// On failure, UnlockKey must not be called since the lock was never acquired.
+func (l Lockable[T]) TryLockKey(key T) bool {
+	vMu := l.lockKey(key)
+
+	if !vMu.TryLock() {
+		if _, _, cleaned := l.unlockKey(key); cleaned {
+			l.releaseToPool(vMu)
+		}
+		return false
+	}
+	return true
+}
+
+// Acquire acquires a read/write lock and returns a func that releases it.
+//
+// The returned func is idempotent: calling it more than once only releases the lock once. This avoids
+// the common mistake of forgetting the matching UnlockKey, and is convenient for defer chains where key
+// is expensive to re-specify.
+func (l Lockable[T]) Acquire(key T) (unlock func()) {
+	l.LockKey(key)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { l.UnlockKey(key) })
+	}
+}
+
 // RLockKey method is used to acquire read locks.
 //
 // Use [Lockable.RLockKey] for read/write locks.
@@ -150,11 +454,14 @@ func (l Lockable[T]) RLockKey(key T) {
 //
 // Can safely be called multiple times on the same key.
 func (l Lockable[T]) RUnlockKey(key T) {
-	vMu, ok := l.unlockKey(key)
+	vMu, ok, cleaned := l.unlockKey(key)
 	if !ok {
 		return
 	}
 	vMu.RUnlock()
+	if cleaned {
+		l.releaseToPool(vMu)
+	}
 }
 
 // RLockKeyDuring before executing fn and release it once done.
@@ -165,51 +472,158 @@ func (l Lockable[T]) RLockKeyDuring(key T, fn func() (any, error)) (any, error)
 	return fn()
 }
 
+// RLockKeyContext is used to acquire a read lock, aborting if ctx is done while waiting for the lock.
+// If the lock is free right away, it's acquired even when ctx is already done.
+//
+// On cancellation, RUnlockKey must not be called since the lock was never acquired.
+func (l Lockable[T]) RLockKeyContext(ctx context.Context, key T) error {
+	vMu := l.lockKey(key)
+
+	if err := vMu.RLockContext(ctx); err != nil {
+		if _, _, cleaned := l.unlockKey(key); cleaned {
+			l.releaseToPool(vMu)
+		}
+		return err
+	}
+	return nil
+}
+
+// TryRLockKey attempts to acquire a read lock without blocking, reporting whether it succeeded.
+//
+// On failure, RUnlockKey must not be called since the lock was never acquired.
+func (l Lockable[T]) TryRLockKey(key T) bool {
+	vMu := l.lockKey(key)
+
+	if !vMu.TryRLock() {
+		if _, _, cleaned := l.unlockKey(key); cleaned {
+			l.releaseToPool(vMu)
+		}
+		return false
+	}
+	return true
+}
+
+// RAcquire acquires a read lock and returns a func that releases it.
+//
+// The returned func is idempotent: calling it more than once only releases the lock once.
+func (l Lockable[T]) RAcquire(key T) (runlock func()) {
+	l.RLockKey(key)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { l.RUnlockKey(key) })
+	}
+}
+
+// UpgradeKey atomically transitions an already-held read lock on key into a write lock, without
+// releasing the key in between (which would let another writer sneak in and invalidate the reader's
+// view). This is useful for read-modify-write patterns where the read phase decides whether a write is
+// actually needed.
+//
+// UpgradeKey must only be called while already holding a read lock on key (e.g. via RLockKey). Like
+// calling Unlock on a [sync.RWMutex] that isn't locked, calling UpgradeKey while no read lock is held on
+// key is a run-time error: it panics. Which goroutine holds a read lock isn't tracked, so a caller without
+// one doesn't panic while another goroutine holds a read lock on key. At most one reader may be upgrading key at a time: if two readers
+// race to call UpgradeKey on the same key, the second call panics rather than deadlocking.
+func (l Lockable[T]) UpgradeKey(key T) {
+	vMu, ok := l.currentVMu(key)
+	if !ok {
+		panic("lockable: UpgradeKey called without holding a read lock on key")
+	}
+	vMu.Upgrade()
+}
+
+// DowngradeKey atomically transitions an already-held write lock on key into a read lock, without
+// releasing the key in between.
+//
+// DowngradeKey must only be called while already holding a write lock on key (e.g. via LockKey). Like
+// calling Unlock on a [sync.RWMutex] that isn't locked, calling DowngradeKey while no write lock is held
+// on key is a run-time error: it panics. Which goroutine holds the write lock isn't tracked, so a caller
+// without it doesn't panic while another goroutine holds the write lock on key.
+func (l Lockable[T]) DowngradeKey(key T) {
+	vMu, ok := l.currentVMu(key)
+	if !ok {
+		panic("lockable: DowngradeKey called without holding a write lock on key")
+	}
+	vMu.Downgrade()
+}
+
+// currentVMu looks up the versionedMutex currently backing key, if any.
+func (l Lockable[T]) currentVMu(key T) (*versionedMutex, bool) {
+	shard := l.shardFor(key)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	vMu, ok := shard.locks[key]
+	return vMu, ok
+}
+
 // IsLocked is used to determine whether a key has been locked without locking the key.
 func (l Lockable[T]) IsLocked(key T) bool {
-	l.locksMu.Lock()
-	defer l.locksMu.Unlock()
+	shard := l.shardFor(key)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
 
-	keyLock, ok := l.locks[key]
+	keyLock, ok := shard.locks[key]
 	return ok && keyLock.completedVersion != keyLock.currentVersion
 }
 
+// shardFor returns the shard key is routed to.
+func (l Lockable[T]) shardFor(key T) *lockShard[T] {
+	return l.shards[l.hasher(key)%uint64(len(l.shards))]
+}
+
 func (l Lockable[T]) lockKey(key T) *versionedMutex {
-	l.locksMu.Lock()
-	defer l.locksMu.Unlock()
+	shard := l.shardFor(key)
 
-	vMu, ok := l.locks[key]
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	vMu, ok := shard.locks[key]
 	if !ok {
-		vMu = &versionedMutex{
-			currentVersion:   0,
-			completedVersion: 0,
-			RWMutex:          sync.RWMutex{},
-		}
-		l.locks[key] = vMu
+		vMu = l.pool.Get().(*versionedMutex)
+		shard.locks[key] = vMu
 	}
 
 	vMu.currentVersion++
 	return vMu
 }
 
-func (l Lockable[T]) unlockKey(key T) (*versionedMutex, bool) {
-	l.locksMu.Lock()
-	defer l.locksMu.Unlock()
+// unlockKey reports whether vMu was found, and whether it was the last pending lock/unlock for key (in
+// which case its entry was removed from the shard and it's up to the caller to return vMu to the pool
+// once it's done calling Unlock/RUnlock on it).
+func (l Lockable[T]) unlockKey(key T) (vMu *versionedMutex, ok bool, cleaned bool) {
+	shard := l.shardFor(key)
 
-	vMu, ok := l.locks[key]
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	vMu, ok = shard.locks[key]
 	if !ok {
-		return nil, false
+		return nil, false, false
 	}
 
 	vMu.completedVersion++
-	l.tryCleanUp(key, vMu)
+	cleaned = tryCleanUp(shard, key, vMu)
 
-	return vMu, true
+	return vMu, true, cleaned
 }
 
 // Clean up the lock if no other locks have been requested for this key
-func (l Lockable[T]) tryCleanUp(key T, vMu *versionedMutex) {
-	if vMu.currentVersion == vMu.completedVersion {
-		delete(l.locks, key)
+func tryCleanUp[T comparable](shard *lockShard[T], key T, vMu *versionedMutex) bool {
+	if vMu.currentVersion != vMu.completedVersion {
+		return false
 	}
+	delete(shard.locks, key)
+	return true
+}
+
+// releaseToPool resets vMu and returns it to the pool. The caller must no longer be holding vMu (i.e. it
+// must have already called Unlock/RUnlock on it, or never successfully locked it at all).
+func (l Lockable[T]) releaseToPool(vMu *versionedMutex) {
+	vMu.currentVersion = 0
+	vMu.completedVersion = 0
+	l.pool.Put(vMu)
 }
@@ -68,10 +68,7 @@ func NewMap[T comparable, V any]() Map[T, V] {
 	return Map[T, V]{
 		internalMap: map[T]V{},
 		mu:          &sync.RWMutex{},
-		Lockable: Lockable[T]{
-			locks:   map[T]*versionedMutex{},
-			locksMu: &sync.Mutex{},
-		},
+		Lockable:    New[T](),
 	}
 }
 
@@ -80,10 +77,7 @@ func NewMap[T comparable, V any]() Map[T, V] {
 // Refer to [MutexMap] for usage.
 func NewMutexMap[T comparable]() MutexMap[T] {
 	return MutexMap[T]{
-		Lockable: Lockable[T]{
-			locks:   map[T]*versionedMutex{},
-			locksMu: &sync.Mutex{},
-		},
+		Lockable: New[T](),
 	}
 }
 
@@ -123,3 +117,67 @@ func (m Map[T, V]) Range(fn func(key T, value V) bool) {
 		}
 	}
 }
+
+// LoadOrStore effectively serves the same purpose as [sync.Map.LoadOrStore]
+func (m Map[T, V]) LoadOrStore(key T, value V) (actual V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if actual, loaded = m.internalMap[key]; loaded {
+		return actual, true
+	}
+
+	m.internalMap[key] = value
+	return value, false
+}
+
+// LoadAndDelete effectively serves the same purpose as [sync.Map.LoadAndDelete]
+func (m Map[T, V]) LoadAndDelete(key T) (value V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	value, loaded = m.internalMap[key]
+	if loaded {
+		delete(m.internalMap, key)
+	}
+	return value, loaded
+}
+
+// Swap effectively serves the same purpose as [sync.Map.Swap]
+func (m Map[T, V]) Swap(key T, value V) (previous V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous, loaded = m.internalMap[key]
+	m.internalMap[key] = value
+	return previous, loaded
+}
+
+// ComparableMap acts the same as [Map], but also supports [ComparableMap.CompareAndSwap], which requires V
+// to be comparable.
+//
+// The zero value is not ready for use. Refer to [NewComparableMap] to create a ready-to-use instance.
+type ComparableMap[T comparable, V comparable] struct {
+	Map[T, V]
+}
+
+// NewComparableMap creates a ready-to-use ComparableMap instance.
+//
+// Refer to [ComparableMap] for usage.
+func NewComparableMap[T comparable, V comparable]() ComparableMap[T, V] {
+	return ComparableMap[T, V]{Map: NewMap[T, V]()}
+}
+
+// CompareAndSwap effectively serves the same purpose as [sync.Map.CompareAndSwap]
+func (m ComparableMap[T, V]) CompareAndSwap(key T, old, new V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.internalMap[key]
+	if !ok || current != old {
+		return false
+	}
+
+	m.internalMap[key] = new
+	return true
+}
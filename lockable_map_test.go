@@ -91,6 +91,71 @@ func TestLockableUMutexMapLock(t *testing.T) {
 	})
 }
 
+func TestMapLoadOrStore(t *testing.T) {
+	m := NewMap[string, int]()
+
+	actual, loaded := m.LoadOrStore("potato", 1)
+	if loaded || actual != 1 {
+		t.Fatalf("expected (1, false), got (%v, %v)", actual, loaded)
+	}
+
+	actual, loaded = m.LoadOrStore("potato", 2)
+	if !loaded || actual != 1 {
+		t.Fatalf("expected (1, true), got (%v, %v)", actual, loaded)
+	}
+}
+
+func TestMapLoadAndDelete(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Store("potato", 1)
+
+	value, loaded := m.LoadAndDelete("potato")
+	if !loaded || value != 1 {
+		t.Fatalf("expected (1, true), got (%v, %v)", value, loaded)
+	}
+
+	if _, loaded := m.LoadAndDelete("potato"); loaded {
+		t.Fatal("expected loaded false after the key was deleted")
+	}
+}
+
+func TestMapSwap(t *testing.T) {
+	m := NewMap[string, int]()
+
+	previous, loaded := m.Swap("potato", 1)
+	if loaded || previous != 0 {
+		t.Fatalf("expected (0, false), got (%v, %v)", previous, loaded)
+	}
+
+	previous, loaded = m.Swap("potato", 2)
+	if !loaded || previous != 1 {
+		t.Fatalf("expected (1, true), got (%v, %v)", previous, loaded)
+	}
+
+	value, _ := m.Load("potato")
+	if value != 2 {
+		t.Fatalf("expected 2, got %v", value)
+	}
+}
+
+func TestComparableMapCompareAndSwap(t *testing.T) {
+	m := NewComparableMap[string, int]()
+	m.Store("potato", 1)
+
+	if m.CompareAndSwap("potato", 2, 3) {
+		t.Fatal("expected CompareAndSwap to fail when old doesn't match the current value")
+	}
+
+	if !m.CompareAndSwap("potato", 1, 3) {
+		t.Fatal("expected CompareAndSwap to succeed when old matches the current value")
+	}
+
+	value, _ := m.Load("potato")
+	if value != 3 {
+		t.Fatalf("expected 3, got %v", value)
+	}
+}
+
 // This benchmark is mostly meaningless.
 //
 // It's only goal is to show that using "per key" locks is much more performant when locking during async code. Duh!
@@ -1,9 +1,11 @@
 package lockable_test
 
 import (
+	"context"
 	"github.com/MysteriousPotato/go-lockable"
 	"sync"
 	"testing"
+	"time"
 )
 
 func TestIsLocked(t *testing.T) {
@@ -20,6 +22,219 @@ func TestIsLocked(t *testing.T) {
 	}
 }
 
+func TestLockKeyContext(t *testing.T) {
+	lock := lockable.New[string]()
+
+	lock.LockKey("potato")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	if err := lock.LockKeyContext(ctx, "potato"); err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	lock.UnlockKey("potato")
+
+	if err := lock.LockKeyContext(context.Background(), "potato"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	lock.UnlockKey("potato")
+}
+
+func TestUpgradeKey(t *testing.T) {
+	lock := lockable.New[string]()
+
+	lock.RLockKey("potato")
+
+	writerStarted := make(chan struct{})
+	writerDone := make(chan struct{})
+	go func() {
+		close(writerStarted)
+		lock.LockKey("potato")
+		defer lock.UnlockKey("potato")
+		close(writerDone)
+	}()
+	<-writerStarted
+	time.Sleep(time.Millisecond * 10) // give the writer a chance to queue up behind our read lock
+
+	lock.UpgradeKey("potato")
+
+	select {
+	case <-writerDone:
+		t.Fatal("expected queued writer to stay blocked until the upgraded lock is released")
+	default:
+	}
+
+	lock.UnlockKey("potato")
+	<-writerDone
+}
+
+func TestUpgradeKeyMultipleReaders(t *testing.T) {
+	lock := lockable.New[string]()
+
+	lock.RLockKey("potato")
+	lock.RLockKey("potato") // a second, independent reader
+
+	upgraded := make(chan struct{})
+	go func() {
+		lock.UpgradeKey("potato")
+		close(upgraded)
+	}()
+
+	time.Sleep(time.Millisecond * 10) // give the upgrader a chance to start draining readers
+	select {
+	case <-upgraded:
+		t.Fatal("expected Upgrade to stay blocked while a second reader still holds the key")
+	default:
+	}
+
+	lock.RUnlockKey("potato") // release the other reader; the upgrader should now be able to proceed
+
+	select {
+	case <-upgraded:
+	case <-time.After(time.Second):
+		t.Fatal("expected Upgrade to unblock once the other reader released its lock")
+	}
+
+	lock.UnlockKey("potato")
+}
+
+func TestUpgradeKeyConcurrentUpgradePanics(t *testing.T) {
+	lock := lockable.New[string]()
+
+	lock.RLockKey("potato")
+	lock.RLockKey("potato") // a second, independent reader
+
+	firstUpgraded := make(chan struct{})
+	go func() {
+		lock.UpgradeKey("potato")
+		close(firstUpgraded)
+	}()
+	time.Sleep(time.Millisecond * 10) // give the first upgrader a chance to set upgrading and start waiting
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("expected a second concurrent UpgradeKey on the same key to panic")
+			}
+		}()
+		lock.UpgradeKey("potato")
+	}()
+
+	lock.RUnlockKey("potato") // release the other reader; the first upgrader should now proceed
+	<-firstUpgraded
+	lock.UnlockKey("potato")
+}
+
+func TestUpgradeKeyRequiresReadLock(t *testing.T) {
+	lock := lockable.New[string]()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected UpgradeKey to panic when called without holding a read lock")
+		}
+	}()
+	lock.UpgradeKey("potato")
+}
+
+func TestDowngradeKey(t *testing.T) {
+	lock := lockable.New[string]()
+
+	lock.LockKey("potato")
+	lock.DowngradeKey("potato")
+
+	if !lock.TryRLockKey("potato") {
+		t.Fatal("expected a second reader to be able to join after downgrade")
+	}
+	lock.RUnlockKey("potato")
+	lock.RUnlockKey("potato")
+}
+
+func TestDowngradeKeyRequiresWriteLock(t *testing.T) {
+	lock := lockable.New[string]()
+
+	// Two readers hold the key, but nobody holds the write lock.
+	lock.RLockKey("potato")
+	lock.RLockKey("potato")
+	defer lock.RUnlockKey("potato")
+	defer lock.RUnlockKey("potato")
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected DowngradeKey to panic when called without holding the write lock")
+		}
+	}()
+	lock.DowngradeKey("potato")
+}
+
+func TestAcquire(t *testing.T) {
+	lock := lockable.New[string]()
+
+	unlock := lock.Acquire("potato")
+	if !lock.IsLocked("potato") {
+		t.Fatal("expected isLocked true, got false")
+	}
+
+	unlock()
+	if lock.IsLocked("potato") {
+		t.Fatal("expected isLocked false, got true")
+	}
+
+	// Must be safe to call more than once.
+	unlock()
+}
+
+func TestLockKeyReusesPooledMutex(t *testing.T) {
+	lock := lockable.New[string]()
+
+	// Repeatedly lock/unlock the same short-lived key so each round cleans up its entry and returns its
+	// versionedMutex to the pool; locking the key again afterwards must behave correctly on a recycled instance.
+	for i := 0; i < 100; i++ {
+		lock.LockKey("potato")
+		lock.UnlockKey("potato")
+	}
+
+	lock.LockKey("potato")
+	if !lock.IsLocked("potato") {
+		t.Fatal("expected isLocked true, got false")
+	}
+	lock.UnlockKey("potato")
+}
+
+func TestShardedLockable(t *testing.T) {
+	// Force every key onto the same shard to make sure cross-shard routing isn't required for correctness.
+	lock := lockable.NewShardedLockable[string](4, func(string) uint64 { return 0 })
+
+	lock.LockKey("potato")
+	if !lock.IsLocked("potato") {
+		t.Fatal("expected isLocked true, got false")
+	}
+	if lock.IsLocked("tomato") {
+		t.Fatal("expected isLocked false, got true")
+	}
+
+	lock.UnlockKey("potato")
+	if lock.IsLocked("potato") {
+		t.Fatal("expected isLocked false, got true")
+	}
+}
+
+func TestTryLockKey(t *testing.T) {
+	lock := lockable.New[string]()
+
+	lock.LockKey("potato")
+	if lock.TryLockKey("potato") {
+		t.Fatal("expected TryLockKey to fail while key is locked")
+	}
+	lock.UnlockKey("potato")
+
+	if !lock.TryLockKey("potato") {
+		t.Fatal("expected TryLockKey to succeed once key is unlocked")
+	}
+	lock.UnlockKey("potato")
+}
+
 func BenchmarkLockableLock(b *testing.B) {
 	l := lockable.New[string]()
 	for i := 0; i < b.N; i++ {
@@ -36,6 +251,34 @@ func BenchmarkLockableRLock(b *testing.B) {
 	}
 }
 
+// BenchmarkLockableLockContended forces a single shard via NewShardedLockable to demonstrate the
+// bottleneck a single map mutex causes under contention: every goroutine walks keys 0, 1, 2, ... and each
+// LockKey/UnlockKey serializes on that one shard's map lock.
+func BenchmarkLockableLockContended(b *testing.B) {
+	l := lockable.NewShardedLockable[int](1, func(key int) uint64 { return uint64(key) })
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			l.LockKey(i)
+			l.UnlockKey(i)
+			i++
+		}
+	})
+}
+
+// BenchmarkShardedLockableLockContended shows the same workload spread across many shards.
+func BenchmarkShardedLockableLockContended(b *testing.B) {
+	l := lockable.New[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			l.LockKey(i)
+			l.UnlockKey(i)
+			i++
+		}
+	})
+}
+
 func BenchmarkStdMutexLock(b *testing.B) {
 	l := &sync.RWMutex{}
 	for i := 0; i < b.N; i++ {
@@ -47,7 +290,7 @@ func BenchmarkStdMutexLock(b *testing.B) {
 func BenchmarkStdMutexRLock(b *testing.B) {
 	mu := &sync.RWMutex{}
 	for i := 0; i < b.N; i++ {
-		mu.Lock()
-		mu.Unlock()
+		mu.RLock()
+		mu.RUnlock()
 	}
 }